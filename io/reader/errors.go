@@ -0,0 +1,16 @@
+package reader
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errNoLines = errors.New("reader: no lines provided")
+
+type lineLengthError struct {
+	line, got, want int
+}
+
+func (e *lineLengthError) Error() string {
+	return fmt.Sprintf("reader: line %d has length %d, want %d", e.line, e.got, e.want)
+}
@@ -0,0 +1,48 @@
+package reader
+
+import (
+	"fmt"
+
+	"maze-solver/maze"
+)
+
+// Parser turns a RawMaze into the maze.Maze graph solvers operate on,
+// locating the start and finish positions.
+type Parser struct{}
+
+// Parse finds start on the first row and finish on the last row of raw -
+// the convention the PNG mazes in examples/ use.
+func (Parser) Parse(raw *RawMaze) (*maze.Maze, error) {
+	start, err := firstFree(raw.Free, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parser: start: %w", err)
+	}
+
+	finish, err := firstFree(raw.Free, raw.Dimension.Height-1)
+	if err != nil {
+		return nil, fmt.Errorf("parser: finish: %w", err)
+	}
+
+	var pois []maze.Position
+	if len(raw.POIs) > 0 {
+		pois = append([]maze.Position{start}, raw.POIs...)
+	}
+
+	return &maze.Maze{
+		Start:     start,
+		Finish:    finish,
+		Dimension: raw.Dimension,
+		Free:      raw.Free,
+		POIs:      pois,
+	}, nil
+}
+
+func firstFree(free *maze.Bitmap, y int) (maze.Position, error) {
+	for x := 0; x < free.Dimension.Width; x++ {
+		if free.Get(x, y) {
+			return maze.Position{X: x, Y: y}, nil
+		}
+	}
+
+	return maze.Position{}, fmt.Errorf("no free cell in line")
+}
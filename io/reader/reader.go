@@ -0,0 +1,254 @@
+// Package reader turns raw input (a PNG file, ASCII art, ...) into a RawMaze,
+// independently of how that raw grid later gets parsed into a solvable maze.
+package reader
+
+import (
+	"image"
+	"image/color"
+	"os"
+
+	"maze-solver/maze"
+)
+
+// RawMaze is the raw grid produced by a Reader, before a Parser turns it into
+// the maze.Maze graph solvers operate on. Free is bit-packed (see
+// maze.Bitmap) rather than a []Line of per-cell structs, so reading a
+// multi-thousand-pixel image doesn't exhaust memory.
+type RawMaze struct {
+	Dimension maze.Dimension
+	Free      *maze.Bitmap
+
+	// POIs are the positions of any points-of-interest markers found while
+	// reading, in scan order. Only PNGReader populates this.
+	POIs []maze.Position
+}
+
+// Reader produces a RawMaze from some input source.
+type Reader interface {
+	Read() (*RawMaze, error)
+}
+
+func isBlack(r, g, b, _ uint32) bool {
+	avg := (r + g + b) / 3
+	return avg < 127
+}
+
+// isPureHue reports whether a pixel is a saturated color rather than a
+// grayscale black/white/gray one, marking it as a point of interest: the
+// channels are far enough apart that it's not just noise or anti-aliasing.
+func isPureHue(r, g, b, _ uint32) bool {
+	max := r
+	if g > max {
+		max = g
+	}
+	if b > max {
+		max = b
+	}
+
+	min := r
+	if g < min {
+		min = g
+	}
+	if b < min {
+		min = b
+	}
+
+	const saturationThreshold = 0x2000 // out of a 0xffff channel range
+	return max-min > saturationThreshold
+}
+
+// clusterPOIs collapses adjacent (4-connected) POI pixels into a single
+// representative position per cluster, so a marker wider than one pixel -
+// or with anti-aliased edges - still produces one point of interest rather
+// than one per pixel.
+func clusterPOIs(pois []maze.Position) []maze.Position {
+	set := sliceIntoSet(pois)
+	seen := make(map[maze.Position]bool, len(pois))
+
+	var clustered []maze.Position
+	for _, p := range pois {
+		if seen[p] {
+			continue
+		}
+
+		clustered = append(clustered, p)
+		floodFill(p, set, seen)
+	}
+
+	return clustered
+}
+
+func sliceIntoSet(positions []maze.Position) map[maze.Position]bool {
+	set := make(map[maze.Position]bool, len(positions))
+	for _, p := range positions {
+		set[p] = true
+	}
+
+	return set
+}
+
+func floodFill(start maze.Position, set, seen map[maze.Position]bool) {
+	seen[start] = true
+	queue := []maze.Position{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, d := range []maze.Position{{X: -1}, {X: 1}, {Y: -1}, {Y: 1}} {
+			n := maze.Position{X: current.X + d.X, Y: current.Y + d.Y}
+			if set[n] && !seen[n] {
+				seen[n] = true
+				queue = append(queue, n)
+			}
+		}
+	}
+}
+
+// PNGReader reads a maze from a PNG (or any format registered with the image
+// package) where one pixel is one cell: black pixels are walls, everything
+// else is free. A pixel with a pure (saturated) hue, such as a pure red or
+// green marker dropped on an otherwise black-and-white maze, is also free
+// and additionally recorded as a point of interest.
+type PNGReader struct {
+	Path string
+}
+
+func (r *PNGReader) Read() (*RawMaze, error) {
+	input, err := os.Open(r.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	img, _, err := image.Decode(input)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Max.X, bounds.Max.Y
+
+	dimension := maze.Dimension{Width: width, Height: height}
+	free := maze.NewBitmap(dimension)
+	var pois []maze.Position
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			free.Set(x, y, !isBlack(r, g, b, a))
+
+			if isPureHue(r, g, b, a) {
+				pois = append(pois, maze.Position{X: x, Y: y})
+			}
+		}
+	}
+
+	return &RawMaze{Dimension: dimension, Free: free, POIs: clusterPOIs(pois)}, nil
+}
+
+// StringsReader reads a maze from ASCII art: one string per row, with
+// PathChar marking a walkable cell and anything else treated as a wall.
+// PathChar defaults to ' ' if left zero.
+type StringsReader struct {
+	Lines    []string
+	PathChar rune
+}
+
+func (r *StringsReader) pathChar() rune {
+	if r.PathChar == 0 {
+		return ' '
+	}
+
+	return r.PathChar
+}
+
+func (r *StringsReader) Read() (*RawMaze, error) {
+	if len(r.Lines) == 0 {
+		return nil, errNoLines
+	}
+
+	width := len([]rune(r.Lines[0]))
+	height := len(r.Lines)
+	pathChar := r.pathChar()
+
+	dimension := maze.Dimension{Width: width, Height: height}
+	free := maze.NewBitmap(dimension)
+	for y, line := range r.Lines {
+		runes := []rune(line)
+		if len(runes) != width {
+			return nil, &lineLengthError{line: y, got: len(runes), want: width}
+		}
+
+		for x, c := range runes {
+			free.Set(x, y, c == pathChar)
+		}
+	}
+
+	return &RawMaze{Dimension: dimension, Free: free}, nil
+}
+
+// ThickWallReader reads a PNG where each maze cell occupies a CellSize x
+// CellSize block of pixels rather than a single pixel. This matters for
+// walls-between-cells mazes: a generator that draws a thin wall line along
+// one edge of a cell's block would make a naive single-pixel-per-cell
+// reader alias onto the wall instead of the cell interior. Sampling the
+// whole block and taking a majority vote avoids that. CellSize defaults to
+// 2 if left zero.
+type ThickWallReader struct {
+	Path     string
+	CellSize int
+}
+
+func (r *ThickWallReader) cellSize() int {
+	if r.CellSize == 0 {
+		return 2
+	}
+
+	return r.CellSize
+}
+
+func (r *ThickWallReader) Read() (*RawMaze, error) {
+	input, err := os.Open(r.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	img, _, err := image.Decode(input)
+	if err != nil {
+		return nil, err
+	}
+
+	cellSize := r.cellSize()
+	bounds := img.Bounds()
+	width, height := bounds.Max.X/cellSize, bounds.Max.Y/cellSize
+
+	dimension := maze.Dimension{Width: width, Height: height}
+	free := maze.NewBitmap(dimension)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			free.Set(x, y, blockIsFree(img, x*cellSize, y*cellSize, cellSize))
+		}
+	}
+
+	return &RawMaze{Dimension: dimension, Free: free}, nil
+}
+
+func blockIsFree(img image.Image, x0, y0, size int) bool {
+	free := 0
+
+	for dy := 0; dy < size; dy++ {
+		for dx := 0; dx < size; dx++ {
+			if !isBlack(pixelAt(img, x0+dx, y0+dy).RGBA()) {
+				free++
+			}
+		}
+	}
+
+	return free*2 >= size*size
+}
+
+func pixelAt(img image.Image, x, y int) color.Color {
+	return img.At(x, y)
+}
@@ -0,0 +1,277 @@
+package reader
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"maze-solver/maze"
+)
+
+// writePNG encodes img to a PNG file under a fresh temp dir and returns its
+// path.
+func writePNG(t *testing.T, img image.Image) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "maze.png")
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	defer f.Close()
+
+	if err := png.Encode(f, img); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	return path
+}
+
+func TestStringsReader(t *testing.T) {
+	r := &StringsReader{Lines: []string{
+		" # ",
+		"   ",
+		" # ",
+	}}
+
+	raw, err := r.Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if raw.Dimension.Width != 3 || raw.Dimension.Height != 3 {
+		t.Fatalf("Dimension = %+v, want 3x3", raw.Dimension)
+	}
+
+	if raw.Free.Get(1, 0) {
+		t.Error("cell (1,0) should be a wall")
+	}
+
+	if !raw.Free.Get(1, 1) {
+		t.Error("cell (1,1) should be free")
+	}
+}
+
+func TestStringsReaderCustomPathChar(t *testing.T) {
+	r := &StringsReader{Lines: []string{"#.#", "..."}, PathChar: '.'}
+
+	raw, err := r.Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if !raw.Free.Get(1, 0) {
+		t.Error("cell (1,0) should be free")
+	}
+}
+
+func TestStringsReaderNoLines(t *testing.T) {
+	r := &StringsReader{}
+	if _, err := r.Read(); err == nil {
+		t.Error("expected an error for empty input")
+	}
+}
+
+func TestStringsReaderMismatchedLineLength(t *testing.T) {
+	r := &StringsReader{Lines: []string{"   ", "  "}}
+	if _, err := r.Read(); err == nil {
+		t.Error("expected an error for mismatched line lengths")
+	}
+}
+
+func TestParser(t *testing.T) {
+	raw, err := (&StringsReader{Lines: []string{
+		"  #",
+		" # ",
+		"#  ",
+	}}).Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	m, err := (Parser{}).Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if m.Start.X != 0 || m.Start.Y != 0 {
+		t.Errorf("Start = %+v, want (0,0)", m.Start)
+	}
+
+	if m.Finish.X != 1 || m.Finish.Y != 2 {
+		t.Errorf("Finish = %+v, want (1,2)", m.Finish)
+	}
+}
+
+func TestParserNoFreeCellInRow(t *testing.T) {
+	raw, err := (&StringsReader{Lines: []string{"##", "  "}}).Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if _, err := (Parser{}).Parse(raw); err == nil {
+		t.Error("expected an error when the start row has no free cell")
+	}
+}
+
+func TestParserNoPOIs(t *testing.T) {
+	raw, err := (&StringsReader{Lines: []string{"  ", "  "}}).Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	m, err := (Parser{}).Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if m.POIs != nil {
+		t.Errorf("POIs = %+v, want nil for a maze with no markers", m.POIs)
+	}
+}
+
+func TestParserSetsPOIsWithStartFirst(t *testing.T) {
+	raw := &RawMaze{
+		Dimension: maze.Dimension{Width: 3, Height: 2},
+		Free:      maze.NewBitmap(maze.Dimension{Width: 3, Height: 2}),
+		POIs:      []maze.Position{{X: 2, Y: 0}},
+	}
+	raw.Free.Set(0, 0, true)
+	raw.Free.Set(2, 0, true)
+	raw.Free.Set(0, 1, true)
+
+	m, err := (Parser{}).Parse(raw)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if len(m.POIs) != 2 || m.POIs[0] != m.Start || m.POIs[1] != (maze.Position{X: 2, Y: 0}) {
+		t.Errorf("POIs = %+v, want [Start, {2 0}]", m.POIs)
+	}
+}
+
+func TestClusterPOIsCollapsesAdjacentPixels(t *testing.T) {
+	pois := []maze.Position{
+		{X: 5, Y: 5}, {X: 6, Y: 5}, {X: 5, Y: 6}, {X: 6, Y: 6},
+		{X: 20, Y: 20},
+	}
+
+	clustered := clusterPOIs(pois)
+	if len(clustered) != 2 {
+		t.Fatalf("clusterPOIs: got %d clusters, want 2: %+v", len(clustered), clustered)
+	}
+}
+
+func TestPNGReader(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	black := color.RGBA{R: 0, G: 0, B: 0, A: 255}
+	red := color.RGBA{R: 255, G: 0, B: 0, A: 255}
+
+	img.Set(0, 0, white)
+	img.Set(1, 0, black)
+	img.Set(2, 0, red)
+	img.Set(0, 1, white)
+	img.Set(1, 1, white)
+	img.Set(2, 1, white)
+
+	r := &PNGReader{Path: writePNG(t, img)}
+	raw, err := r.Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if raw.Dimension.Width != 3 || raw.Dimension.Height != 2 {
+		t.Fatalf("Dimension = %+v, want 3x2", raw.Dimension)
+	}
+
+	if raw.Free.Get(1, 0) {
+		t.Error("black pixel (1,0) should be a wall")
+	}
+
+	if !raw.Free.Get(2, 0) {
+		t.Error("red pixel (2,0) should be free")
+	}
+
+	if len(raw.POIs) != 1 || raw.POIs[0] != (maze.Position{X: 2, Y: 0}) {
+		t.Errorf("POIs = %+v, want [{2 0}]", raw.POIs)
+	}
+}
+
+func TestPNGReaderMissingFile(t *testing.T) {
+	r := &PNGReader{Path: filepath.Join(t.TempDir(), "missing.png")}
+	if _, err := r.Read(); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestThickWallReader(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	black := color.RGBA{R: 0, G: 0, B: 0, A: 255}
+
+	// Left 2x2 cell: all white (free). Right 2x2 cell: all black (wall).
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			img.Set(x, y, white)
+			img.Set(x+2, y, black)
+		}
+	}
+
+	r := &ThickWallReader{Path: writePNG(t, img)}
+	raw, err := r.Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if raw.Dimension.Width != 2 || raw.Dimension.Height != 1 {
+		t.Fatalf("Dimension = %+v, want 2x1", raw.Dimension)
+	}
+
+	if !raw.Free.Get(0, 0) {
+		t.Error("left cell should be free")
+	}
+
+	if raw.Free.Get(1, 0) {
+		t.Error("right cell should be a wall")
+	}
+}
+
+func TestBlockIsFreeMajorityVote(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	black := color.RGBA{R: 0, G: 0, B: 0, A: 255}
+
+	img.Set(0, 0, white)
+	img.Set(1, 0, white)
+	img.Set(0, 1, black)
+	img.Set(1, 1, black)
+
+	if !blockIsFree(img, 0, 0, 2) {
+		t.Error("a 2x2 block exactly half free should tie-break free")
+	}
+
+	img.Set(1, 0, black)
+	if blockIsFree(img, 0, 0, 2) {
+		t.Error("a 2x2 block with only one free pixel should be a wall")
+	}
+}
+
+func TestIsPureHue(t *testing.T) {
+	white := uint32(0xffff)
+	if isPureHue(white, white, white, white) {
+		t.Error("white should not be a pure hue")
+	}
+
+	if isPureHue(0, 0, 0, 0xffff) {
+		t.Error("black should not be a pure hue")
+	}
+
+	if !isPureHue(0xffff, 0, 0, 0xffff) {
+		t.Error("pure red should be a pure hue")
+	}
+}
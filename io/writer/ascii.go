@@ -0,0 +1,17 @@
+package writer
+
+import (
+	"os"
+
+	"maze-solver/maze"
+)
+
+// ASCIIWriter writes a solved maze's String() representation - walls as
+// '█', free cells as ' ', the solution path as '+' - to a file.
+type ASCIIWriter struct {
+	Path string
+}
+
+func (w *ASCIIWriter) Write(m *maze.SolvedMaze) error {
+	return os.WriteFile(w.Path, []byte(m.String()), 0644)
+}
@@ -0,0 +1,34 @@
+package writer
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"maze-solver/maze"
+)
+
+func TestASCIIWriterWritesWallsFreeAndPath(t *testing.T) {
+	path := []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}}
+	m := buildSolvedMaze(writerMaze, path)
+	outPath := filepath.Join(t.TempDir(), "out.txt")
+
+	w := &ASCIIWriter{Path: outPath}
+	if err := w.Write(m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	got := readFile(t, outPath)
+
+	if got != m.String() {
+		t.Errorf("ASCIIWriter.Write wrote %q, want m.String() %q", got, m.String())
+	}
+
+	if !strings.Contains(got, "++") {
+		t.Errorf("expected the solution path rendered as '+', got:\n%s", got)
+	}
+
+	if !strings.Contains(got, "█") {
+		t.Errorf("expected the wall cell rendered as '█', got:\n%s", got)
+	}
+}
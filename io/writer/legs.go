@@ -0,0 +1,60 @@
+package writer
+
+import (
+	"image/color"
+
+	"maze-solver/maze"
+)
+
+// legHues are the base hues cycled through when coloring a multi-goal
+// tour's legs, spaced for visual contrast between consecutive legs.
+var legHues = []float64{0, 210, 120, 40, 280, 160, 320, 60}
+
+// legGradient builds a pale-to-saturated gradient around hue, for the i'th
+// leg of a tour.
+func legGradient(i int) Gradient {
+	h := legHues[i%len(legHues)]
+
+	return Gradient{Stops: []GradientStop{
+		{Offset: 0, Color: hslToRGB(h, 0.35, 0.85, 255)},
+		{Offset: 1, Color: hslToRGB(h, 0.85, 0.45, 255)},
+	}}
+}
+
+// pathColorsForLegs assigns a color to every position in path, grouping it
+// into legs at the given waypoints (each a position in path) and coloring
+// each leg with its own legGradient, so a multi-goal tour reads as a
+// sequence of distinct segments rather than one flat gradient. With fewer
+// than two waypoints it returns an empty map.
+func pathColorsForLegs(path, waypoints []maze.Position) map[maze.Position]color.RGBA {
+	colors := make(map[maze.Position]color.RGBA, len(path))
+	if len(waypoints) < 2 {
+		return colors
+	}
+
+	legStart := 0
+	leg := 0
+
+	for i := 1; i < len(path); i++ {
+		if path[i] != waypoints[leg+1] {
+			continue
+		}
+
+		applyLegGradient(colors, path[legStart:i+1], leg)
+
+		legStart = i
+		leg++
+
+		if leg == len(waypoints)-1 {
+			break
+		}
+	}
+
+	return colors
+}
+
+func applyLegGradient(colors map[maze.Position]color.RGBA, leg []maze.Position, legIndex int) {
+	for i, c := range legGradient(legIndex).Colors(len(leg)) {
+		colors[leg[i]] = c
+	}
+}
@@ -0,0 +1,123 @@
+package writer
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"maze-solver/maze"
+)
+
+func buildSolvedMaze(rows []string, path []maze.Position) *maze.SolvedMaze {
+	dimension := maze.Dimension{Width: len(rows[0]), Height: len(rows)}
+	free := maze.NewBitmap(dimension)
+	for y, row := range rows {
+		for x, c := range row {
+			free.Set(x, y, c != '#')
+		}
+	}
+
+	return &maze.SolvedMaze{
+		Maze: maze.Maze{Dimension: dimension, Free: free},
+		Path: path,
+	}
+}
+
+func readPNG(t *testing.T, path string) image.Image {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	return img
+}
+
+var writerMaze = []string{
+	"..",
+	"#.",
+}
+
+func TestPNGWriterWallAndFreeColors(t *testing.T) {
+	m := buildSolvedMaze(writerMaze, nil)
+	path := filepath.Join(t.TempDir(), "out.png")
+
+	w := &PNGWriter{Path: path, CellWidth: 2, CellHeight: 2}
+	if err := w.Write(m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	img := readPNG(t, path)
+
+	if got := img.At(0, 0); !sameRGBA(got, w.pathColor()) {
+		t.Errorf("free cell (0,0) = %v, want %v", got, w.pathColor())
+	}
+
+	if got := img.At(0, 3); !sameRGBA(got, w.wallColor()) {
+		t.Errorf("wall cell (0,1) = %v, want %v", got, w.wallColor())
+	}
+
+	if got := img.At(3, 3); !sameRGBA(got, w.pathColor()) {
+		t.Errorf("free cell (1,1) = %v, want %v", got, w.pathColor())
+	}
+}
+
+func TestPNGWriterPathPaintsOverBackground(t *testing.T) {
+	path := []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}}
+	m := buildSolvedMaze(writerMaze, path)
+	outPath := filepath.Join(t.TempDir(), "out.png")
+
+	solutionColor := color.RGBA{R: 1, G: 2, B: 3, A: 255}
+	w := &PNGWriter{
+		Path:             outPath,
+		CellWidth:        2,
+		CellHeight:       2,
+		SolutionGradient: Gradient{Stops: []GradientStop{{Offset: 0, Color: solutionColor}, {Offset: 1, Color: solutionColor}}},
+	}
+	if err := w.Write(m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	img := readPNG(t, outPath)
+
+	if got := img.At(0, 0); !sameRGBA(got, solutionColor) {
+		t.Errorf("path cell (0,0) = %v, want the solution color %v, not the background", got, solutionColor)
+	}
+}
+
+func TestPNGWriterColorsLegsDistinctly(t *testing.T) {
+	path := []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}
+	m := buildSolvedMaze(writerMaze, path)
+	m.Waypoints = []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}
+	outPath := filepath.Join(t.TempDir(), "out.png")
+
+	w := &PNGWriter{Path: outPath, CellWidth: 2, CellHeight: 2}
+	if err := w.Write(m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	img := readPNG(t, outPath)
+
+	firstLeg := img.At(0, 0)
+	secondLeg := img.At(2, 2)
+	if sameRGBA(firstLeg, secondLeg) {
+		t.Errorf("expected distinct colors across legs, got %v for both", firstLeg)
+	}
+}
+
+func sameRGBA(c, want color.Color) bool {
+	r, g, b, a := c.RGBA()
+	wr, wg, wb, wa := want.RGBA()
+
+	return r == wr && g == wg && b == wb && a == wa
+}
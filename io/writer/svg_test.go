@@ -0,0 +1,93 @@
+package writer
+
+import (
+	"image/color"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"maze-solver/maze"
+)
+
+func TestSVGWriterWallAndFreeColors(t *testing.T) {
+	m := buildSolvedMaze(writerMaze, nil)
+	outPath := filepath.Join(t.TempDir(), "out.svg")
+
+	wallColor := color.RGBA{R: 1, G: 2, B: 3, A: 255}
+	w := &SVGWriter{Path: outPath, CellWidth: 2, CellHeight: 2, WallColor: wallColor}
+	if err := w.Write(m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	svg := readFile(t, outPath)
+
+	if !strings.Contains(svg, hexColor(wallColor)) {
+		t.Errorf("svg should contain the wall color %s:\n%s", hexColor(wallColor), svg)
+	}
+
+	if !strings.Contains(svg, hexColor(w.pathColor())) {
+		t.Errorf("svg background rect should use the path color %s:\n%s", hexColor(w.pathColor()), svg)
+	}
+}
+
+func TestSVGWriterPathPaintsOverBackground(t *testing.T) {
+	path := []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}}
+	m := buildSolvedMaze(writerMaze, path)
+	outPath := filepath.Join(t.TempDir(), "out.svg")
+
+	solutionColor := color.RGBA{R: 9, G: 8, B: 7, A: 255}
+	backgroundColor := color.RGBA{R: 250, G: 250, B: 250, A: 255}
+	w := &SVGWriter{
+		Path:             outPath,
+		CellWidth:        2,
+		CellHeight:       2,
+		PathColor:        backgroundColor,
+		SolutionGradient: Gradient{Stops: []GradientStop{{Offset: 0, Color: solutionColor}, {Offset: 1, Color: solutionColor}}},
+	}
+	if err := w.Write(m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	svg := readFile(t, outPath)
+
+	if !strings.Contains(svg, hexColor(solutionColor)) {
+		t.Errorf("svg should contain the solution color %s painted over the background:\n%s", hexColor(solutionColor), svg)
+	}
+}
+
+func TestSVGWriterColorsLegsDistinctly(t *testing.T) {
+	path := []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}
+	m := buildSolvedMaze(writerMaze, path)
+	m.Waypoints = []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}}
+	outPath := filepath.Join(t.TempDir(), "out.svg")
+
+	w := &SVGWriter{Path: outPath, CellWidth: 2, CellHeight: 2}
+	if err := w.Write(m); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	svg := readFile(t, outPath)
+
+	firstLegColor := hexColor(pathColorsForLegs(path, m.Waypoints)[maze.Position{X: 0, Y: 0}])
+	secondLegColor := hexColor(pathColorsForLegs(path, m.Waypoints)[maze.Position{X: 1, Y: 1}])
+
+	if firstLegColor == secondLegColor {
+		t.Fatalf("test fixture produced identical leg colors %s, can't assert distinctness", firstLegColor)
+	}
+
+	if !strings.Contains(svg, firstLegColor) || !strings.Contains(svg, secondLegColor) {
+		t.Errorf("svg should contain both distinct leg colors %s and %s:\n%s", firstLegColor, secondLegColor, svg)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,86 @@
+package writer
+
+import (
+	"image"
+	"image/color"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+const defaultTileSize = 256
+
+// ProgressFunc is called after each tile finishes rendering, so callers can
+// show progress on long renders. done and total are in tiles, not pixels.
+type ProgressFunc func(done, total int)
+
+// tileRects splits bounds into tileSize x tileSize (or smaller, at the
+// edges) non-overlapping rectangles.
+func tileRects(bounds image.Rectangle, tileSize int) []image.Rectangle {
+	var tiles []image.Rectangle
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += tileSize {
+		for x := bounds.Min.X; x < bounds.Max.X; x += tileSize {
+			tiles = append(tiles, image.Rect(x, y, min(x+tileSize, bounds.Max.X), min(y+tileSize, bounds.Max.Y)))
+		}
+	}
+
+	return tiles
+}
+
+// renderTiles dispatches render, one call per tile of img, to a worker pool
+// sized to runtime.NumCPU(). This is what makes toImage-style rendering fast
+// at 4K+ resolutions: per-pixel img.Set in a single-threaded nested loop
+// dominates runtime once the image gets large, and tiles are independent
+// since each covers disjoint pixels.
+func renderTiles(img *image.RGBA, tileSize int, render func(tile image.Rectangle), progress ProgressFunc) {
+	if tileSize == 0 {
+		tileSize = defaultTileSize
+	}
+
+	tiles := tileRects(img.Bounds(), tileSize)
+
+	tileCh := make(chan image.Rectangle)
+	var done int64
+
+	var wg sync.WaitGroup
+	workers := runtime.NumCPU()
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tile := range tileCh {
+				render(tile)
+
+				if progress != nil {
+					progress(int(atomic.AddInt64(&done, 1)), len(tiles))
+				}
+			}
+		}()
+	}
+
+	for _, tile := range tiles {
+		tileCh <- tile
+	}
+	close(tileCh)
+
+	wg.Wait()
+}
+
+// fillRect paints r (clipped to img's bounds) with c, writing straight into
+// img.Pix via stride math instead of calling img.Set per pixel.
+func fillRect(img *image.RGBA, r image.Rectangle, c color.RGBA) {
+	r = r.Intersect(img.Bounds())
+	if r.Empty() {
+		return
+	}
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		offset := img.PixOffset(r.Min.X, y)
+		for x := r.Min.X; x < r.Max.X; x++ {
+			px := img.Pix[offset : offset+4 : offset+4]
+			px[0], px[1], px[2], px[3] = c.R, c.G, c.B, c.A
+			offset += 4
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Package writer renders a solved maze to some output format (PNG, ASCII,
+// SVG, ...), independently of how the maze was read or solved.
+package writer
+
+import (
+	"image/color"
+	"math"
+
+	"maze-solver/maze"
+)
+
+// Writer renders a solved maze to its destination.
+type Writer interface {
+	Write(m *maze.SolvedMaze) error
+}
+
+// GradientStop is a color anchored at an offset in [0, 1] along a gradient.
+type GradientStop struct {
+	Offset float64
+	Color  color.RGBA
+}
+
+// Gradient interpolates between a sequence of GradientStops in HSL space,
+// which avoids the muddy grays a straight RGB lerp produces between two
+// saturated colors.
+type Gradient struct {
+	Stops []GradientStop
+}
+
+// defaultGradient is a red ramp over the same colors as the original
+// hardcoded path coloring, in reverse order: pale pink at the start of the
+// path, saturated red at the end.
+func defaultGradient() Gradient {
+	return Gradient{Stops: []GradientStop{
+		{Offset: 0, Color: color.RGBA{R: 255, G: 200, B: 200, A: 255}},
+		{Offset: 1, Color: color.RGBA{R: 255, G: 50, B: 50, A: 255}},
+	}}
+}
+
+// Colors returns n colors sampled evenly along the gradient, one per step of
+// a solution path.
+func (g Gradient) Colors(n int) []color.RGBA {
+	colors := make([]color.RGBA, n)
+
+	if n == 0 {
+		return colors
+	}
+
+	if n == 1 {
+		colors[0] = g.at(0)
+		return colors
+	}
+
+	for i := 0; i < n; i++ {
+		colors[i] = g.at(float64(i) / float64(n-1))
+	}
+
+	return colors
+}
+
+func (g Gradient) at(t float64) color.RGBA {
+	stops := g.Stops
+	if len(stops) == 0 {
+		stops = defaultGradient().Stops
+	}
+
+	if t <= stops[0].Offset {
+		return stops[0].Color
+	}
+
+	last := stops[len(stops)-1]
+	if t >= last.Offset {
+		return last.Color
+	}
+
+	for i := 1; i < len(stops); i++ {
+		if t > stops[i].Offset {
+			continue
+		}
+
+		prev := stops[i-1]
+		span := stops[i].Offset - prev.Offset
+		local := (t - prev.Offset) / span
+
+		return lerpHSL(prev.Color, stops[i].Color, local)
+	}
+
+	return last.Color
+}
+
+func lerpHSL(a, b color.RGBA, t float64) color.RGBA {
+	h1, s1, l1 := rgbToHSL(a)
+	h2, s2, l2 := rgbToHSL(b)
+
+	h := lerpHue(h1, h2, t)
+	s := s1 + (s2-s1)*t
+	l := l1 + (l2-l1)*t
+
+	return hslToRGB(h, s, l, a.A)
+}
+
+func lerpHue(h1, h2, t float64) float64 {
+	d := h2 - h1
+
+	switch {
+	case d > 180:
+		d -= 360
+	case d < -180:
+		d += 360
+	}
+
+	h := h1 + d*t
+	if h < 0 {
+		h += 360
+	}
+
+	return math.Mod(h, 360)
+}
+
+func rgbToHSL(c color.RGBA) (h, s, l float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+
+	if max == min {
+		return 0, 0, l
+	}
+
+	d := max - min
+	if l > 0.5 {
+		s = d / (2 - max - min)
+	} else {
+		s = d / (max + min)
+	}
+
+	switch max {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+
+	return h, s, l
+}
+
+func hslToRGB(h, s, l float64, a uint8) color.RGBA {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	return color.RGBA{
+		R: uint8((r + m) * 255),
+		G: uint8((g + m) * 255),
+		B: uint8((b + m) * 255),
+		A: a,
+	}
+}
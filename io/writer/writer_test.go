@@ -0,0 +1,103 @@
+package writer
+
+import (
+	"image"
+	"image/color"
+	"sync/atomic"
+	"testing"
+
+	"maze-solver/maze"
+)
+
+func TestGradientColorsEndpoints(t *testing.T) {
+	g := Gradient{Stops: []GradientStop{
+		{Offset: 0, Color: color.RGBA{R: 255, A: 255}},
+		{Offset: 1, Color: color.RGBA{B: 255, A: 255}},
+	}}
+
+	colors := g.Colors(3)
+	if len(colors) != 3 {
+		t.Fatalf("Colors: got %d colors, want 3", len(colors))
+	}
+
+	if colors[0] != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("first color = %+v, want the start stop", colors[0])
+	}
+
+	if colors[2] != (color.RGBA{B: 255, A: 255}) {
+		t.Errorf("last color = %+v, want the end stop", colors[2])
+	}
+}
+
+func TestGradientColorsSingleStep(t *testing.T) {
+	g := defaultGradient()
+
+	colors := g.Colors(1)
+	if len(colors) != 1 {
+		t.Fatalf("Colors: got %d colors, want 1", len(colors))
+	}
+
+	if colors[0] != g.Stops[0].Color {
+		t.Errorf("single-step color = %+v, want the first stop", colors[0])
+	}
+}
+
+func TestRenderTilesCoversWholeImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 10, 10))
+
+	var tilesSeen int64
+	renderTiles(img, 4, func(tile image.Rectangle) {
+		atomic.AddInt64(&tilesSeen, 1)
+		fillRect(img, tile, color.RGBA{R: 1, A: 255})
+	}, nil)
+
+	if tilesSeen == 0 {
+		t.Fatal("renderTiles: no tiles rendered")
+	}
+
+	for i := 0; i < len(img.Pix); i += 4 {
+		if img.Pix[i] != 1 {
+			t.Fatalf("pixel at offset %d not painted: %v", i, img.Pix[i:i+4])
+		}
+	}
+}
+
+func TestRenderTilesProgress(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 10, 10))
+
+	var calls int64
+	renderTiles(img, 4, func(image.Rectangle) {}, func(done, total int) {
+		atomic.AddInt64(&calls, 1)
+		if done > total {
+			t.Errorf("done %d exceeds total %d", done, total)
+		}
+	})
+
+	if calls == 0 {
+		t.Error("progress callback was never called")
+	}
+}
+
+func TestPathColorsForLegsColorsEachLegDistinctly(t *testing.T) {
+	path := []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}}
+	waypoints := []maze.Position{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}}
+
+	colors := pathColorsForLegs(path, waypoints)
+	if len(colors) != len(path) {
+		t.Fatalf("pathColorsForLegs: got %d colors, want %d", len(colors), len(path))
+	}
+
+	firstLeg := colors[maze.Position{X: 1, Y: 0}]
+	secondLeg := colors[maze.Position{X: 3, Y: 0}]
+	if firstLeg == secondLeg {
+		t.Errorf("expected distinct colors across legs, got %+v for both", firstLeg)
+	}
+}
+
+func TestPathColorsForLegsNoWaypoints(t *testing.T) {
+	path := []maze.Position{{X: 0, Y: 0}, {X: 1, Y: 0}}
+
+	if colors := pathColorsForLegs(path, nil); len(colors) != 0 {
+		t.Errorf("pathColorsForLegs: got %d colors, want 0 with no waypoints", len(colors))
+	}
+}
@@ -0,0 +1,41 @@
+package maze
+
+// ChunkSize is the number of bits packed into a single byte of a Bitmap.
+const ChunkSize = 8
+
+// Bitmap is a bit-packed grid of booleans. A []bool or map[Position]bool
+// costs a full byte (or much more, for a map) per cell; packing ChunkSize
+// cells per byte keeps multi-thousand-pixel mazes from running the machine
+// out of memory, both for the maze's own free/wall grid and for a solver's
+// per-solve visited tracking.
+type Bitmap struct {
+	Dimension Dimension
+	Data      [][]byte
+}
+
+// NewBitmap allocates a zeroed Bitmap sized for dimension.
+func NewBitmap(dimension Dimension) *Bitmap {
+	rowBytes := (dimension.Width + ChunkSize - 1) / ChunkSize
+
+	data := make([][]byte, dimension.Height)
+	for y := range data {
+		data[y] = make([]byte, rowBytes)
+	}
+
+	return &Bitmap{Dimension: dimension, Data: data}
+}
+
+// Get reports whether the bit at (x, y) is set.
+func (b *Bitmap) Get(x, y int) bool {
+	return b.Data[y][x/ChunkSize]&(1<<uint(x%ChunkSize)) != 0
+}
+
+// Set sets the bit at (x, y) to v.
+func (b *Bitmap) Set(x, y int, v bool) {
+	mask := byte(1 << uint(x%ChunkSize))
+	if v {
+		b.Data[y][x/ChunkSize] |= mask
+	} else {
+		b.Data[y][x/ChunkSize] &^= mask
+	}
+}
@@ -0,0 +1,52 @@
+package maze
+
+import "testing"
+
+func TestBitmapGetSetRoundTrip(t *testing.T) {
+	b := NewBitmap(Dimension{Width: 10, Height: 3})
+
+	b.Set(0, 0, true)
+	b.Set(9, 0, true)
+	b.Set(7, 2, true)
+
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 10; x++ {
+			want := (x == 0 && y == 0) || (x == 9 && y == 0) || (x == 7 && y == 2)
+			if got := b.Get(x, y); got != want {
+				t.Errorf("Get(%d,%d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestBitmapSetClearsBit(t *testing.T) {
+	b := NewBitmap(Dimension{Width: 4, Height: 1})
+
+	b.Set(2, 0, true)
+	if !b.Get(2, 0) {
+		t.Fatal("bit should be set")
+	}
+
+	b.Set(2, 0, false)
+	if b.Get(2, 0) {
+		t.Error("bit should be cleared")
+	}
+}
+
+// TestBitmapWidthNotMultipleOfChunkSize exercises the byte-boundary math in
+// Get/Set for a width that doesn't divide evenly into ChunkSize, since a
+// naive rowBytes calculation would silently truncate the last few columns.
+func TestBitmapWidthNotMultipleOfChunkSize(t *testing.T) {
+	width := ChunkSize + 3
+	b := NewBitmap(Dimension{Width: width, Height: 1})
+
+	for x := 0; x < width; x++ {
+		b.Set(x, 0, true)
+	}
+
+	for x := 0; x < width; x++ {
+		if !b.Get(x, 0) {
+			t.Errorf("Get(%d,0) = false, want true", x)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Package maze defines the graph that solvers and readers/writers operate on.
+package maze
+
+import "fmt"
+
+type Position struct {
+	X, Y int
+}
+
+type Dimension struct {
+	Width, Height int
+}
+
+// Maze is a bit-packed grid: Free holds one bit per cell rather than a
+// []Line of structs, so a 10000x10000 maze costs megabytes instead of
+// hundreds of megabytes.
+type Maze struct {
+	Start, Finish Position
+	Dimension     Dimension
+	Free          *Bitmap
+
+	// POIs are the maze's points of interest, for multi-goal solving.
+	// POIs[0] is always Start; it's nil for mazes with no marked POIs.
+	POIs []Position
+}
+
+type SolvedMaze struct {
+	Maze
+	Path []Position
+
+	// Waypoints are the POIs visited along Path, in visiting order. It's
+	// nil for a single-goal solve; a multi-goal solve sets it so a Writer
+	// can color each leg of the tour distinctly.
+	Waypoints []Position
+}
+
+func (m *Maze) InRange(p Position) bool {
+	return p.Y >= 0 && p.Y < m.Dimension.Height && p.X >= 0 && p.X < m.Dimension.Width
+}
+
+// IsFree reports whether p is a walkable cell.
+func (m *Maze) IsFree(p Position) bool {
+	return m.Free.Get(p.X, p.Y)
+}
+
+// Neighbors returns the in-range, walkable cells around p. It does not track
+// visited state - that's the solver's job, via a Visited bitmap allocated
+// per solve, since the maze is shared across solves.
+func (m *Maze) Neighbors(p Position) []Position {
+	candidates := []Position{
+		{X: p.X - 1, Y: p.Y},
+		{X: p.X, Y: p.Y + 1},
+		{X: p.X + 1, Y: p.Y},
+		{X: p.X, Y: p.Y - 1},
+	}
+
+	neighbors := make([]Position, 0)
+	for _, c := range candidates {
+		if m.InRange(c) && m.IsFree(c) {
+			neighbors = append(neighbors, c)
+		}
+	}
+
+	return neighbors
+}
+
+func sliceIntoBooleanMap[T comparable](s []T) map[T]bool {
+	elementMap := make(map[T]bool)
+
+	for _, x := range s {
+		elementMap[x] = true
+	}
+
+	return elementMap
+}
+
+func stringifyLine(free *Bitmap, y int, pathMap map[Position]bool) string {
+	s := ""
+	for x := 0; x < free.Dimension.Width; x++ {
+		p := Position{X: x, Y: y}
+		if pathMap[p] {
+			s += "+"
+		} else if free.Get(x, y) {
+			s += " "
+		} else {
+			s += "█"
+		}
+	}
+
+	s += "\n"
+
+	return s
+}
+
+func stringifyMazeStats(m Maze) string {
+	s := ""
+	s += "Dimensions (w x h): " + fmt.Sprint(m.Dimension.Width) + " x " + fmt.Sprint(m.Dimension.Height) + "\n"
+	s += "Start Position (x,y): (" + fmt.Sprint(m.Start.X) + "," + fmt.Sprint(m.Start.Y) + ")\n"
+	s += "Finish Position (x,y): (" + fmt.Sprint(m.Finish.X) + "," + fmt.Sprint(m.Finish.Y) + ")\n"
+
+	return s
+}
+
+func (m Maze) String() string {
+	s := stringifyMazeStats(m)
+
+	pathMap := sliceIntoBooleanMap(make([]Position, 0))
+	for y := 0; y < m.Dimension.Height; y++ {
+		s += stringifyLine(m.Free, y, pathMap)
+	}
+
+	return s
+}
+
+func (m SolvedMaze) String() string {
+	s := stringifyMazeStats(m.Maze)
+
+	pathMap := sliceIntoBooleanMap(m.Path)
+
+	for y := 0; y < m.Dimension.Height; y++ {
+		s += stringifyLine(m.Free, y, pathMap)
+	}
+
+	return s
+}
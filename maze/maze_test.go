@@ -0,0 +1,85 @@
+package maze
+
+import "testing"
+
+func buildMaze(rows []string) *Maze {
+	dimension := Dimension{Width: len(rows[0]), Height: len(rows)}
+	free := NewBitmap(dimension)
+	for y, row := range rows {
+		for x, c := range row {
+			free.Set(x, y, c != '#')
+		}
+	}
+
+	return &Maze{Dimension: dimension, Free: free}
+}
+
+var neighborsMaze = []string{
+	"...",
+	".#.",
+	"...",
+}
+
+func TestInRange(t *testing.T) {
+	m := buildMaze(neighborsMaze)
+
+	cases := []struct {
+		p    Position
+		want bool
+	}{
+		{Position{X: 0, Y: 0}, true},
+		{Position{X: 2, Y: 2}, true},
+		{Position{X: -1, Y: 0}, false},
+		{Position{X: 0, Y: -1}, false},
+		{Position{X: 3, Y: 0}, false},
+		{Position{X: 0, Y: 3}, false},
+	}
+
+	for _, c := range cases {
+		if got := m.InRange(c.p); got != c.want {
+			t.Errorf("InRange(%+v) = %v, want %v", c.p, got, c.want)
+		}
+	}
+}
+
+func TestNeighborsExcludesWallsAndOutOfRange(t *testing.T) {
+	m := buildMaze(neighborsMaze)
+
+	neighbors := m.Neighbors(Position{X: 1, Y: 0})
+
+	want := map[Position]bool{
+		{X: 0, Y: 0}: true,
+		{X: 2, Y: 0}: true,
+	}
+
+	if len(neighbors) != len(want) {
+		t.Fatalf("Neighbors = %+v, want %d entries", neighbors, len(want))
+	}
+
+	for _, n := range neighbors {
+		if !want[n] {
+			t.Errorf("unexpected neighbor %+v", n)
+		}
+	}
+}
+
+func TestNeighborsCorner(t *testing.T) {
+	m := buildMaze(neighborsMaze)
+
+	neighbors := m.Neighbors(Position{X: 0, Y: 0})
+
+	want := map[Position]bool{
+		{X: 1, Y: 0}: true,
+		{X: 0, Y: 1}: true,
+	}
+
+	if len(neighbors) != len(want) {
+		t.Fatalf("Neighbors = %+v, want %d entries", neighbors, len(want))
+	}
+
+	for _, n := range neighbors {
+		if !want[n] {
+			t.Errorf("unexpected neighbor %+v", n)
+		}
+	}
+}
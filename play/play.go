@@ -0,0 +1,121 @@
+// Package play implements an interactive terminal mode where a user steps
+// through a maze with the arrow keys, independent of the batch solver path.
+package play
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nsf/termbox-go"
+
+	"maze-solver/maze"
+)
+
+// Player tracks a user's live progress through a maze: their current
+// position, how many steps they've taken, and which cells they've visited.
+type Player struct {
+	pos     maze.Position
+	steps   int
+	visited map[maze.Position]bool
+}
+
+func newPlayer(start maze.Position) *Player {
+	return &Player{pos: start, visited: map[maze.Position]bool{start: true}}
+}
+
+func (p *Player) move(m *maze.Maze, d maze.Position) {
+	next := maze.Position{X: p.pos.X + d.X, Y: p.pos.Y + d.Y}
+	if !m.InRange(next) || !m.IsFree(next) {
+		return
+	}
+
+	p.pos = next
+	p.steps++
+	p.visited[next] = true
+}
+
+var directions = map[termbox.Key]maze.Position{
+	termbox.KeyArrowUp:    {X: 0, Y: -1},
+	termbox.KeyArrowDown:  {X: 0, Y: 1},
+	termbox.KeyArrowLeft:  {X: -1, Y: 0},
+	termbox.KeyArrowRight: {X: 1, Y: 0},
+}
+
+// Play drops the user into an interactive TUI: arrow keys move a cursor
+// from m.Start, walls block movement, visited cells are highlighted, and
+// reaching m.Finish reports the step count and elapsed time. Esc or Ctrl-C
+// quits early.
+func Play(m *maze.Maze) error {
+	if err := termbox.Init(); err != nil {
+		return fmt.Errorf("play: %w", err)
+	}
+	defer termbox.Close()
+
+	player := newPlayer(m.Start)
+	started := time.Now()
+
+	render(m, player, "")
+
+	for {
+		event := termbox.PollEvent()
+		if event.Type != termbox.EventKey {
+			continue
+		}
+
+		if event.Key == termbox.KeyEsc || event.Key == termbox.KeyCtrlC {
+			return nil
+		}
+
+		if d, ok := directions[event.Key]; ok {
+			player.move(m, d)
+		}
+
+		if player.pos == m.Finish {
+			elapsed := time.Since(started).Round(time.Millisecond)
+			render(m, player, fmt.Sprintf("Solved in %d steps, %s - press Esc to quit", player.steps, elapsed))
+			waitForQuit()
+			return nil
+		}
+
+		render(m, player, "")
+	}
+}
+
+func waitForQuit() {
+	for {
+		event := termbox.PollEvent()
+		if event.Type == termbox.EventKey && (event.Key == termbox.KeyEsc || event.Key == termbox.KeyCtrlC) {
+			return
+		}
+	}
+}
+
+func render(m *maze.Maze, player *Player, status string) {
+	termbox.Clear(termbox.ColorDefault, termbox.ColorDefault)
+
+	for y := 0; y < m.Dimension.Height; y++ {
+		for x := 0; x < m.Dimension.Width; x++ {
+			ch, fg := cellGlyph(m, player, maze.Position{X: x, Y: y})
+			termbox.SetCell(x, y, ch, fg, termbox.ColorDefault)
+		}
+	}
+
+	for x, r := range status {
+		termbox.SetCell(x, m.Dimension.Height+1, r, termbox.ColorDefault, termbox.ColorDefault)
+	}
+
+	termbox.Flush()
+}
+
+func cellGlyph(m *maze.Maze, player *Player, p maze.Position) (rune, termbox.Attribute) {
+	switch {
+	case p == player.pos:
+		return '@', termbox.ColorYellow
+	case !m.IsFree(p):
+		return '█', termbox.ColorDefault
+	case player.visited[p]:
+		return '.', termbox.ColorCyan
+	default:
+		return ' ', termbox.ColorDefault
+	}
+}
@@ -0,0 +1,92 @@
+package play
+
+import (
+	"testing"
+
+	"maze-solver/maze"
+)
+
+// buildMaze turns rows of '#' (wall) / '.' (free) into a Maze, mirroring the
+// helper in the solver package's tests.
+func buildMaze(rows []string) *maze.Maze {
+	dimension := maze.Dimension{Width: len(rows[0]), Height: len(rows)}
+	free := maze.NewBitmap(dimension)
+	for y, row := range rows {
+		for x, c := range row {
+			free.Set(x, y, c != '#')
+		}
+	}
+
+	return &maze.Maze{Dimension: dimension, Free: free}
+}
+
+var playMaze = []string{
+	"...",
+	".#.",
+	"...",
+}
+
+func TestMoveStepsIntoFreeCell(t *testing.T) {
+	m := buildMaze(playMaze)
+	p := newPlayer(maze.Position{X: 0, Y: 0})
+
+	p.move(m, maze.Position{X: 1, Y: 0})
+
+	if p.pos != (maze.Position{X: 1, Y: 0}) {
+		t.Errorf("pos = %+v, want (1,0)", p.pos)
+	}
+
+	if p.steps != 1 {
+		t.Errorf("steps = %d, want 1", p.steps)
+	}
+
+	if !p.visited[maze.Position{X: 1, Y: 0}] {
+		t.Error("new position should be marked visited")
+	}
+}
+
+func TestMoveBlockedByWall(t *testing.T) {
+	m := buildMaze(playMaze)
+	p := newPlayer(maze.Position{X: 1, Y: 0})
+
+	p.move(m, maze.Position{X: 0, Y: 1})
+
+	if p.pos != (maze.Position{X: 1, Y: 0}) {
+		t.Errorf("pos = %+v, want unchanged (1,0)", p.pos)
+	}
+
+	if p.steps != 0 {
+		t.Errorf("steps = %d, want 0", p.steps)
+	}
+}
+
+func TestMoveBlockedOutOfRange(t *testing.T) {
+	m := buildMaze(playMaze)
+	p := newPlayer(maze.Position{X: 0, Y: 0})
+
+	p.move(m, maze.Position{X: -1, Y: 0})
+
+	if p.pos != (maze.Position{X: 0, Y: 0}) {
+		t.Errorf("pos = %+v, want unchanged (0,0)", p.pos)
+	}
+
+	if p.steps != 0 {
+		t.Errorf("steps = %d, want 0", p.steps)
+	}
+}
+
+func TestMoveBackAndForthDoesNotDoubleCountVisited(t *testing.T) {
+	m := buildMaze(playMaze)
+	p := newPlayer(maze.Position{X: 0, Y: 0})
+
+	p.move(m, maze.Position{X: 1, Y: 0})
+	p.move(m, maze.Position{X: -1, Y: 0})
+
+	if p.steps != 2 {
+		t.Errorf("steps = %d, want 2", p.steps)
+	}
+
+	if len(p.visited) != 2 {
+		t.Errorf("visited has %d entries, want 2", len(p.visited))
+	}
+}
@@ -0,0 +1,142 @@
+package solver
+
+import (
+	"errors"
+	"math"
+
+	"maze-solver/maze"
+)
+
+// ErrNoTour is returned when SolveMultiGoal can't connect every POI into a
+// single tour, either because one is unreachable from another or because
+// the maze has no POIs at all.
+var ErrNoTour = errors.New("solver: no tour connects all points of interest")
+
+const unreachable = math.MaxInt32
+
+// SolveMultiGoal finds the shortest tour that starts at m.POIs[0] (which is
+// always m.Start) and visits every other POI. It computes all-pairs shortest
+// paths between POIs with BFS, then solves for the visiting order with a
+// bitmask DP over those distances - the classic Held-Karp approach,
+// O(2^N * N^2), feasible up to ~20 POIs. The returned path is the
+// concatenation of the BFS path for each leg of the tour, and Waypoints
+// records the POIs in visiting order so a Writer can color each leg
+// separately.
+func SolveMultiGoal(m *maze.Maze) (*maze.SolvedMaze, error) {
+	n := len(m.POIs)
+	if n == 0 {
+		return nil, ErrNoTour
+	}
+
+	dist := make([][]int, n)
+	parents := make([]map[maze.Position]maze.Position, n)
+
+	for i, poi := range m.POIs {
+		d, parent := bfsDistances(m, poi)
+		parents[i] = parent
+
+		dist[i] = make([]int, n)
+		for j, other := range m.POIs {
+			if i == j {
+				continue
+			}
+
+			steps, ok := d[other]
+			if !ok {
+				return nil, ErrNoTour
+			}
+
+			dist[i][j] = steps
+		}
+	}
+
+	order, err := shortestTourOrder(dist)
+	if err != nil {
+		return nil, err
+	}
+
+	waypoints := make([]maze.Position, len(order))
+	for i, poi := range order {
+		waypoints[i] = m.POIs[poi]
+	}
+
+	path := []maze.Position{waypoints[0]}
+	for i := 1; i < len(order); i++ {
+		from, to := order[i-1], order[i]
+		leg := reconstructPath(parents[from], m.POIs[from], m.POIs[to])
+		path = append(path, leg[1:]...)
+	}
+
+	return &maze.SolvedMaze{Maze: *m, Path: path, Waypoints: waypoints}, nil
+}
+
+// shortestTourOrder runs the Held-Karp DP over dist (an NxN matrix of
+// shortest distances between POIs) and returns the order of POI indices -
+// starting with 0 - that visits all of them most cheaply.
+//
+// dp[mask][i] is the cheapest cost of a path that has visited exactly the
+// POIs in mask and currently sits at POI i; choice[mask][i] is the POI
+// visited right before i on that cheapest path, which lets us walk the DP
+// table back into a concrete order once it's filled in.
+func shortestTourOrder(dist [][]int) ([]int, error) {
+	n := len(dist)
+	full := 1<<n - 1
+
+	dp := make([][]int, 1<<n)
+	choice := make([][]int, 1<<n)
+	for mask := range dp {
+		dp[mask] = make([]int, n)
+		choice[mask] = make([]int, n)
+		for i := range dp[mask] {
+			dp[mask][i] = unreachable
+			choice[mask][i] = -1
+		}
+	}
+
+	dp[1][0] = 0
+
+	for mask := 1; mask <= full; mask++ {
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 || dp[mask][i] == unreachable {
+				continue
+			}
+
+			for j := 0; j < n; j++ {
+				if mask&(1<<j) != 0 {
+					continue
+				}
+
+				next := mask | 1<<j
+				if cost := dp[mask][i] + dist[i][j]; cost < dp[next][j] {
+					dp[next][j] = cost
+					choice[next][j] = i
+				}
+			}
+		}
+	}
+
+	last, best := -1, unreachable
+	for i := 0; i < n; i++ {
+		if dp[full][i] < best {
+			best, last = dp[full][i], i
+		}
+	}
+
+	if last == -1 {
+		return nil, ErrNoTour
+	}
+
+	order := make([]int, 0, n)
+	for mask, i := full, last; i != -1; {
+		order = append(order, i)
+		prev := choice[mask][i]
+		mask &^= 1 << i
+		i = prev
+	}
+
+	for l, r := 0, len(order)-1; l < r; l, r = l+1, r-1 {
+		order[l], order[r] = order[r], order[l]
+	}
+
+	return order, nil
+}
@@ -0,0 +1,77 @@
+package solver
+
+import (
+	"testing"
+
+	"maze-solver/maze"
+)
+
+// buildMultiGoalMaze is like buildMaze, but also sets POIs (Start plus one
+// Position per extra marker), matching how reader.Parser populates them.
+func buildMultiGoalMaze(rows []string, extraPOIs []maze.Position) *maze.Maze {
+	m := buildMaze(rows)
+	m.POIs = append([]maze.Position{m.Start}, extraPOIs...)
+
+	return m
+}
+
+var multiGoalMaze = []string{
+	".........",
+	".........",
+	".........",
+	".........",
+	".........",
+	".........",
+	".........",
+}
+
+func TestSolveMultiGoalVisitsEveryPOI(t *testing.T) {
+	m := buildMultiGoalMaze(multiGoalMaze, []maze.Position{
+		{X: 4, Y: 2},
+		{X: 4, Y: 4},
+	})
+
+	solved, err := SolveMultiGoal(m)
+	if err != nil {
+		t.Fatalf("SolveMultiGoal: %v", err)
+	}
+
+	visited := make(map[maze.Position]bool, len(solved.Path))
+	for _, p := range solved.Path {
+		visited[p] = true
+	}
+
+	for _, poi := range m.POIs {
+		if !visited[poi] {
+			t.Errorf("path never visits POI %+v", poi)
+		}
+	}
+
+	if len(solved.Waypoints) != len(m.POIs) {
+		t.Errorf("Waypoints has %d entries, want %d", len(solved.Waypoints), len(m.POIs))
+	}
+
+	if solved.Waypoints[0] != m.Start {
+		t.Errorf("Waypoints[0] = %+v, want Start %+v", solved.Waypoints[0], m.Start)
+	}
+}
+
+func TestSolveMultiGoalNoPOIs(t *testing.T) {
+	m := buildMaze(multiGoalMaze)
+
+	if _, err := SolveMultiGoal(m); err != ErrNoTour {
+		t.Errorf("SolveMultiGoal: got err %v, want %v", err, ErrNoTour)
+	}
+}
+
+func TestSolveMultiGoalUnreachablePOI(t *testing.T) {
+	m := buildMultiGoalMaze([]string{
+		".#.",
+		".#.",
+		".#.",
+	}, []maze.Position{{X: 2, Y: 0}})
+
+	if _, err := SolveMultiGoal(m); err != ErrNoTour {
+		t.Errorf("SolveMultiGoal: got err %v, want %v", err, ErrNoTour)
+	}
+}
@@ -0,0 +1,218 @@
+// Package solver implements pluggable maze-solving algorithms.
+package solver
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+
+	"maze-solver/maze"
+)
+
+// ErrNoPath is returned when no path exists between a maze's start and finish.
+var ErrNoPath = errors.New("solver: no path between start and finish")
+
+// Algorithm names a Solver implementation, so callers can select one from a
+// CLI flag or config field.
+type Algorithm string
+
+const (
+	DFS   Algorithm = "dfs"
+	BFS   Algorithm = "bfs"
+	AStar Algorithm = "astar"
+)
+
+// Solver finds a path through a maze from its start to its finish.
+type Solver interface {
+	Solve(m *maze.Maze) (*maze.SolvedMaze, error)
+}
+
+// New returns the Solver registered for the given algorithm name.
+func New(algorithm Algorithm) (Solver, error) {
+	switch algorithm {
+	case DFS:
+		return &DFSSolver{}, nil
+	case BFS:
+		return &BFSSolver{}, nil
+	case AStar:
+		return &AStarSolver{}, nil
+	default:
+		return nil, fmt.Errorf("solver: unknown algorithm %q", algorithm)
+	}
+}
+
+// reconstructPath walks parent pointers from finish back to start and
+// reverses the result into a start->finish path.
+func reconstructPath(parent map[maze.Position]maze.Position, start, finish maze.Position) []maze.Position {
+	path := []maze.Position{finish}
+
+	for current := finish; current != start; {
+		current = parent[current]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// DFSSolver walks the maze depth-first using an explicit stack, so it never
+// blows the call stack on large mazes and never mutates the shared Maze.
+// It returns the first path it finds to the finish, which is not guaranteed
+// to be the shortest - use BFSSolver or AStarSolver for that.
+type DFSSolver struct{}
+
+func (s *DFSSolver) Solve(m *maze.Maze) (*maze.SolvedMaze, error) {
+	visited := maze.NewBitmap(m.Dimension)
+	visited.Set(m.Start.X, m.Start.Y, true)
+	parent := map[maze.Position]maze.Position{}
+	stack := []maze.Position{m.Start}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current == m.Finish {
+			return &maze.SolvedMaze{Maze: *m, Path: reconstructPath(parent, m.Start, m.Finish)}, nil
+		}
+
+		for _, n := range m.Neighbors(current) {
+			if !visited.Get(n.X, n.Y) {
+				visited.Set(n.X, n.Y, true)
+				parent[n] = current
+				stack = append(stack, n)
+			}
+		}
+	}
+
+	return nil, ErrNoPath
+}
+
+// BFSSolver walks the maze breadth-first, which guarantees the shortest path
+// in an unweighted maze.
+type BFSSolver struct{}
+
+func (s *BFSSolver) Solve(m *maze.Maze) (*maze.SolvedMaze, error) {
+	visited := maze.NewBitmap(m.Dimension)
+	visited.Set(m.Start.X, m.Start.Y, true)
+	parent := map[maze.Position]maze.Position{}
+	queue := []maze.Position{m.Start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == m.Finish {
+			return &maze.SolvedMaze{Maze: *m, Path: reconstructPath(parent, m.Start, m.Finish)}, nil
+		}
+
+		for _, n := range m.Neighbors(current) {
+			if !visited.Get(n.X, n.Y) {
+				visited.Set(n.X, n.Y, true)
+				parent[n] = current
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	return nil, ErrNoPath
+}
+
+// bfsDistances runs a breadth-first search over the whole maze from start,
+// returning the shortest distance in steps to every reachable cell and the
+// BFS parent pointers needed to reconstruct a path to any of them. It's the
+// building block SolveMultiGoal uses to get all-pairs distances between
+// points of interest.
+func bfsDistances(m *maze.Maze, start maze.Position) (map[maze.Position]int, map[maze.Position]maze.Position) {
+	dist := map[maze.Position]int{start: 0}
+	parent := map[maze.Position]maze.Position{}
+	queue := []maze.Position{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, n := range m.Neighbors(current) {
+			if _, seen := dist[n]; seen {
+				continue
+			}
+
+			dist[n] = dist[current] + 1
+			parent[n] = current
+			queue = append(queue, n)
+		}
+	}
+
+	return dist, parent
+}
+
+func manhattan(a, b maze.Position) int {
+	return abs(a.X-b.X) + abs(a.Y-b.Y)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
+
+// astarNode is an open-set entry ordered by f = g + h.
+type astarNode struct {
+	pos  maze.Position
+	g, f int
+}
+
+type astarQueue []astarNode
+
+func (q astarQueue) Len() int            { return len(q) }
+func (q astarQueue) Less(i, j int) bool  { return q[i].f < q[j].f }
+func (q astarQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *astarQueue) Push(x interface{}) { *q = append(*q, x.(astarNode)) }
+func (q *astarQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+// AStarSolver uses Manhattan distance to the finish as its heuristic, which
+// is admissible on a 4-connected grid and guarantees the shortest path.
+type AStarSolver struct{}
+
+func (s *AStarSolver) Solve(m *maze.Maze) (*maze.SolvedMaze, error) {
+	gScore := map[maze.Position]int{m.Start: 0}
+	parent := map[maze.Position]maze.Position{}
+	closed := maze.NewBitmap(m.Dimension)
+
+	open := &astarQueue{{pos: m.Start, g: 0, f: manhattan(m.Start, m.Finish)}}
+	heap.Init(open)
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(astarNode)
+
+		if closed.Get(current.pos.X, current.pos.Y) {
+			continue
+		}
+		closed.Set(current.pos.X, current.pos.Y, true)
+
+		if current.pos == m.Finish {
+			return &maze.SolvedMaze{Maze: *m, Path: reconstructPath(parent, m.Start, m.Finish)}, nil
+		}
+
+		for _, n := range m.Neighbors(current.pos) {
+			tentativeG := current.g + 1
+			if existing, ok := gScore[n]; !ok || tentativeG < existing {
+				gScore[n] = tentativeG
+				parent[n] = current.pos
+				heap.Push(open, astarNode{pos: n, g: tentativeG, f: tentativeG + manhattan(n, m.Finish)})
+			}
+		}
+	}
+
+	return nil, ErrNoPath
+}
@@ -0,0 +1,104 @@
+package solver
+
+import (
+	"testing"
+
+	"maze-solver/maze"
+)
+
+// buildMaze turns rows of '#' (wall) / '.' (free) into a Maze, with start at
+// the first free cell of the top row and finish at the first free cell of
+// the bottom row.
+func buildMaze(rows []string) *maze.Maze {
+	dimension := maze.Dimension{Width: len(rows[0]), Height: len(rows)}
+	free := maze.NewBitmap(dimension)
+	for y, row := range rows {
+		for x, c := range row {
+			free.Set(x, y, c != '#')
+		}
+	}
+
+	m := &maze.Maze{Dimension: dimension, Free: free}
+	m.Start = findFree(free, 0)
+	m.Finish = findFree(free, len(rows)-1)
+
+	return m
+}
+
+func findFree(free *maze.Bitmap, y int) maze.Position {
+	for x := 0; x < free.Dimension.Width; x++ {
+		if free.Get(x, y) {
+			return maze.Position{X: x, Y: y}
+		}
+	}
+
+	panic("no free cell in line")
+}
+
+var shortestPathMaze = []string{
+	".#...",
+	".#.#.",
+	".#.#.",
+	"...#.",
+	"##.#.",
+	"...#.",
+}
+
+func TestSolversFindShortestPath(t *testing.T) {
+	m := buildMaze(shortestPathMaze)
+
+	for _, algorithm := range []Algorithm{BFS, AStar} {
+		s, err := New(algorithm)
+		if err != nil {
+			t.Fatalf("%s: New: %v", algorithm, err)
+		}
+
+		solved, err := s.Solve(m)
+		if err != nil {
+			t.Fatalf("%s: Solve: %v", algorithm, err)
+		}
+
+		if got, want := len(solved.Path), 10; got != want {
+			t.Errorf("%s: path length = %d, want %d", algorithm, got, want)
+		}
+
+		if solved.Path[0] != m.Start || solved.Path[len(solved.Path)-1] != m.Finish {
+			t.Errorf("%s: path does not run from start to finish: %v", algorithm, solved.Path)
+		}
+	}
+}
+
+func TestDFSFindsAPath(t *testing.T) {
+	m := buildMaze(shortestPathMaze)
+
+	s, _ := New(DFS)
+	solved, err := s.Solve(m)
+	if err != nil {
+		t.Fatalf("Solve: %v", err)
+	}
+
+	if solved.Path[0] != m.Start || solved.Path[len(solved.Path)-1] != m.Finish {
+		t.Errorf("path does not run from start to finish: %v", solved.Path)
+	}
+}
+
+func TestNoPath(t *testing.T) {
+	m := buildMaze([]string{
+		".#.",
+		"##.",
+		".#.",
+	})
+
+	for _, algorithm := range []Algorithm{DFS, BFS, AStar} {
+		s, _ := New(algorithm)
+		if _, err := s.Solve(m); err != ErrNoPath {
+			t.Errorf("%s: Solve: got err %v, want %v", algorithm, err, ErrNoPath)
+		}
+	}
+}
+
+func TestNewUnknownAlgorithm(t *testing.T) {
+	if _, err := New("bogus"); err == nil {
+		t.Error("New: expected error for unknown algorithm")
+	}
+}